@@ -2,30 +2,66 @@ package main
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
 )
 
+// maxLineSize is the largest input line the scanner will accept.
+const maxLineSize = 1000000
+
+// readLine returns the next input line, io.EOF at the end of input, or the
+// scanner's error if the read failed.
+func readLine(scanner *bufio.Scanner) (string, error) {
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return "", err
+		}
+		return "", io.EOF
+	}
+	return scanner.Text(), nil
+}
+
 /**
  * Win the water fight by controlling the most territory, or out-soak your opponent!
  **/
 
 func main() {
 	scanner := bufio.NewScanner(os.Stdin)
-	scanner.Buffer(make([]byte, 1000000), 1000000)
+	scanner.Buffer(make([]byte, maxLineSize), maxLineSize)
 	var inputs []string
 
+	// nextLine stops the bot on a failed read instead of looping on empty
+	// lines. The input may only end cleanly where a turn would start; an end
+	// anywhere else is a truncated stream.
+	nextLine := func(turnStart bool) string {
+		line, err := readLine(scanner)
+		switch {
+		case err == nil:
+			return line
+		case errors.Is(err, io.EOF) && turnStart:
+			os.Exit(0)
+		case errors.Is(err, io.EOF):
+			fmt.Fprintln(os.Stderr, "unexpected end of input")
+		case errors.Is(err, bufio.ErrTooLong):
+			fmt.Fprintf(os.Stderr, "input line exceeds %d bytes: %v\n", maxLineSize, err)
+		default:
+			fmt.Fprintf(os.Stderr, "failed to read input: %v\n", err)
+		}
+		os.Exit(1)
+		return ""
+	}
+
 	// myId: Your player id (0 or 1)
 	var myId int
-	scanner.Scan()
-	fmt.Sscan(scanner.Text(), &myId)
+	fmt.Sscan(nextLine(false), &myId)
 
 	// agentCount: Total number of agents in the game
 	var agentCount int
-	scanner.Scan()
-	fmt.Sscan(scanner.Text(), &agentCount)
+	fmt.Sscan(nextLine(false), &agentCount)
 
 	for i := 0; i < agentCount; i++ {
 		// agentId: Unique identifier for this agent
@@ -35,18 +71,15 @@ func main() {
 		// soakingPower: Damage output within optimal conditions
 		// splashBombs: Number of splash bombs this can throw this game
 		var agentId, player, shootCooldown, optimalRange, soakingPower, splashBombs int
-		scanner.Scan()
-		fmt.Sscan(scanner.Text(), &agentId, &player, &shootCooldown, &optimalRange, &soakingPower, &splashBombs)
+		fmt.Sscan(nextLine(false), &agentId, &player, &shootCooldown, &optimalRange, &soakingPower, &splashBombs)
 	}
 	// width: Width of the game map
 	// height: Height of the game map
 	var width, height int
-	scanner.Scan()
-	fmt.Sscan(scanner.Text(), &width, &height)
+	fmt.Sscan(nextLine(false), &width, &height)
 
 	for i := 0; i < height; i++ {
-		scanner.Scan()
-		inputs = strings.Split(scanner.Text(), " ")
+		inputs = strings.Split(nextLine(false), " ")
 		for j := 0; j < width; j++ {
 			// x: X coordinate, 0 is left edge
 			// y: Y coordinate, 0 is top edge
@@ -60,19 +93,16 @@ func main() {
 	}
 	for {
 		var agentCount int
-		scanner.Scan()
-		fmt.Sscan(scanner.Text(), &agentCount)
+		fmt.Sscan(nextLine(true), &agentCount)
 		for i := 0; i < agentCount; i++ {
 			// cooldown: Number of turns before this agent can shoot
 			// wetness: Damage (0-100) this agent has taken
 			var agentId, x, y, cooldown, splashBombs, wetness int
-			scanner.Scan()
-			fmt.Sscan(scanner.Text(), &agentId, &x, &y, &cooldown, &splashBombs, &wetness)
+			fmt.Sscan(nextLine(false), &agentId, &x, &y, &cooldown, &splashBombs, &wetness)
 		}
 		// myAgentCount: Number of alive agents controlled by you
 		var myAgentCount int
-		scanner.Scan()
-		fmt.Sscan(scanner.Text(), &myAgentCount)
+		fmt.Sscan(nextLine(false), &myAgentCount)
 
 		for i := 0; i < myAgentCount; i++ {
 
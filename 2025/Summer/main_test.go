@@ -0,0 +1,26 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestReadLineTooLong(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader(strings.Repeat("1", 64) + "\n"))
+	scanner.Buffer(make([]byte, 16), 16)
+
+	if _, err := readLine(scanner); !errors.Is(err, bufio.ErrTooLong) {
+		t.Fatalf("readLine() error = %v, want %v", err, bufio.ErrTooLong)
+	}
+}
+
+func TestReadLineEOF(t *testing.T) {
+	scanner := bufio.NewScanner(strings.NewReader(""))
+
+	if _, err := readLine(scanner); !errors.Is(err, io.EOF) {
+		t.Fatalf("readLine() error = %v, want %v", err, io.EOF)
+	}
+}